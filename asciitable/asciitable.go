@@ -0,0 +1,70 @@
+// Package asciitable renders simple left-aligned, whitespace-padded
+// tables for jrdev's non-interactive CLI output.
+package asciitable
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Table is a set of rows rendered under a fixed header.
+type Table struct {
+	Headers []string
+	Rows    [][]string
+}
+
+// New returns an empty Table with the given column headers.
+func New(headers []string) *Table {
+	return &Table{Headers: headers}
+}
+
+// AddRow appends a row. len(cols) should match len(t.Headers).
+func (t *Table) AddRow(cols ...string) {
+	t.Rows = append(t.Rows, cols)
+}
+
+// Render returns the table as aligned, newline-terminated rows.
+func (t *Table) Render() string {
+	widths := t.columnWidths()
+
+	var b strings.Builder
+	writeRow(&b, t.Headers, widths)
+	writeSeparator(&b, widths)
+	for _, row := range t.Rows {
+		writeRow(&b, row, widths)
+	}
+
+	return b.String()
+}
+
+func (t *Table) columnWidths() []int {
+	widths := make([]int, len(t.Headers))
+	for i, h := range t.Headers {
+		widths[i] = len(h)
+	}
+
+	for _, row := range t.Rows {
+		for i, col := range row {
+			if len(col) > widths[i] {
+				widths[i] = len(col)
+			}
+		}
+	}
+
+	return widths
+}
+
+func writeRow(b *strings.Builder, cols []string, widths []int) {
+	for i, col := range cols {
+		fmt.Fprintf(b, "%-*s  ", widths[i], col)
+	}
+	b.WriteByte('\n')
+}
+
+func writeSeparator(b *strings.Builder, widths []int) {
+	for _, w := range widths {
+		b.WriteString(strings.Repeat("-", w))
+		b.WriteString("  ")
+	}
+	b.WriteByte('\n')
+}
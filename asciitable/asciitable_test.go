@@ -0,0 +1,19 @@
+package asciitable
+
+import "testing"
+
+func TestRenderAlignsColumns(t *testing.T) {
+	table := New([]string{"Coin", "Value"})
+	table.AddRow("bitcoin", "1.00")
+	table.AddRow("eth", "123.45")
+
+	got := table.Render()
+	want := "Coin     Value   \n" +
+		"-------  ------  \n" +
+		"bitcoin  1.00    \n" +
+		"eth      123.45  \n"
+
+	if got != want {
+		t.Errorf("Render() = %q, want %q", got, want)
+	}
+}
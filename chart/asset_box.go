@@ -0,0 +1,29 @@
+package chart
+
+import "github.com/presstab/jrdev/market"
+
+// AssetBox renders a single coin's candles inside the chart grid.
+type AssetBox struct {
+	CoinName string
+
+	// Candles is the re-binned series currently on screen, fetched for
+	// whichever TimeRange was last selected for this coin.
+	Candles []market.Candle
+
+	// CostBasisLine holds the y-values (one per rendered column) of the
+	// holder's average cost basis, overlaid on top of the candles. It is
+	// nil when the coin has no portfolio holding.
+	CostBasisLine []float64
+
+	disposed bool
+}
+
+// NewAssetBox creates an AssetBox for coinName.
+func NewAssetBox(coinName string) *AssetBox {
+	return &AssetBox{CoinName: coinName}
+}
+
+// Dispose releases resources held by the box.
+func (a *AssetBox) Dispose() {
+	a.disposed = true
+}
@@ -0,0 +1,23 @@
+package chart
+
+// ContextMenu is the right-click/keyboard menu shown over an AssetBox.
+type ContextMenu struct {
+	// Coin is the AssetBox the menu was opened over.
+	Coin string
+
+	// X, Y is where the menu is anchored, in terminal cells. Set when
+	// the menu is opened by a mouse click; zero for the keyboard path.
+	X, Y int
+
+	disposed bool
+}
+
+// NewContextMenu creates a ContextMenu for coin, anchored at x, y.
+func NewContextMenu(coin string, x, y int) *ContextMenu {
+	return &ContextMenu{Coin: coin, X: x, Y: y}
+}
+
+// Dispose releases resources held by the menu.
+func (c *ContextMenu) Dispose() {
+	c.disposed = true
+}
@@ -0,0 +1,159 @@
+package chart
+
+// MouseButton identifies which mouse action generated an event.
+type MouseButton int
+
+const (
+	MouseLeft MouseButton = iota
+	MouseScrollUp
+	MouseScrollDown
+)
+
+// MouseEvent is a mouse action over an AssetBox, in terminal cells
+// relative to the chart area.
+type MouseEvent struct {
+	Button MouseButton
+	X, Y   int
+}
+
+// Key is a keyboard-only equivalent of the mouse interactions, so the
+// feature degrades cleanly when EnableMouse is false.
+type Key string
+
+const (
+	KeyToggleMenu Key = "m"
+	KeyPanLeft    Key = "left"
+	KeyPanRight   Key = "right"
+	KeyZoomIn     Key = "+"
+	KeyZoomOut    Key = "-"
+	KeyNextRange  Key = "]"
+	KeyPrevRange  Key = "["
+)
+
+// panZoomSteps is how many steps a pan or zoom divides the current
+// range into.
+const panZoomSteps = 10
+
+// HandleMouse dispatches a mouse event for coin: a left click opens the
+// ContextMenu at the cursor, and scrolling zooms the time axis. It is a
+// no-op if EnableMouse is false.
+func (p *PriceChart) HandleMouse(coin string, ev MouseEvent) error {
+	if !p.EnableMouse {
+		return nil
+	}
+
+	switch ev.Button {
+	case MouseLeft:
+		p.openContextMenu(coin, ev.X, ev.Y)
+		return nil
+	case MouseScrollUp:
+		return p.Zoom(coin, -1)
+	case MouseScrollDown:
+		return p.Zoom(coin, 1)
+	default:
+		return nil
+	}
+}
+
+// HandleDragSelect feeds a mouse drag's start/end, given as Unix
+// seconds, to TimeRangeDialog as a custom range and redraws coin's
+// AssetBox. It is a no-op if EnableMouse is false.
+func (p *PriceChart) HandleDragSelect(coin string, startUnix, endUnix int64) error {
+	if !p.EnableMouse {
+		return nil
+	}
+
+	return p.SetTimeRange(coin, p.TimeRangeDialog.Custom(startUnix, endUnix))
+}
+
+// HandleKey is the keyboard-only equivalent of HandleMouse/HandleDragSelect.
+func (p *PriceChart) HandleKey(coin string, key Key) error {
+	switch key {
+	case KeyToggleMenu:
+		p.toggleContextMenu(coin)
+		return nil
+	case KeyPanLeft:
+		return p.Pan(coin, -1)
+	case KeyPanRight:
+		return p.Pan(coin, 1)
+	case KeyZoomIn:
+		return p.Zoom(coin, -1)
+	case KeyZoomOut:
+		return p.Zoom(coin, 1)
+	case KeyNextRange:
+		return p.SetTimeRange(coin, p.TimeRangeDialog.Next())
+	case KeyPrevRange:
+		return p.SetTimeRange(coin, p.TimeRangeDialog.Previous())
+	default:
+		return nil
+	}
+}
+
+func (p *PriceChart) openContextMenu(coin string, x, y int) {
+	if p.ContextMenu != nil {
+		p.ContextMenu.Dispose()
+	}
+	p.ContextMenu = NewContextMenu(coin, x, y)
+}
+
+func (p *PriceChart) toggleContextMenu(coin string) {
+	if p.ContextMenu != nil {
+		p.ContextMenu.Dispose()
+		p.ContextMenu = nil
+		return
+	}
+
+	p.openContextMenu(coin, 0, 0)
+}
+
+// Pan shifts coin's current range by one step in direction (-1 for
+// earlier, 1 for later) and redraws its AssetBox.
+func (p *PriceChart) Pan(coin string, direction int) error {
+	tr := p.rangeFor(coin)
+	shift := step(tr) * int64(direction)
+
+	return p.SetTimeRange(coin, TimeRange{
+		Preset: PresetCustom,
+		Start:  tr.Start + shift,
+		End:    tr.End + shift,
+	})
+}
+
+// Zoom narrows (direction -1) or widens (direction 1) coin's current
+// range by one step and redraws its AssetBox.
+func (p *PriceChart) Zoom(coin string, direction int) error {
+	tr := p.rangeFor(coin)
+	delta := step(tr) * int64(direction)
+
+	// direction -1 (zoom in) should shrink the window by pulling Start
+	// forward, the opposite of Pan's shift - hence the subtraction.
+	newStart := tr.Start - delta
+	if newStart >= tr.End {
+		return nil
+	}
+
+	return p.SetTimeRange(coin, TimeRange{
+		Preset: PresetCustom,
+		Start:  newStart,
+		End:    tr.End,
+	})
+}
+
+func step(tr TimeRange) int64 {
+	step := (tr.End - tr.Start) / panZoomSteps
+	if step == 0 {
+		step = 1
+	}
+	return step
+}
+
+// rangeFor returns the range coin is currently displaying, falling back
+// to its last-remembered preset if it has never been drawn this
+// session.
+func (p *PriceChart) rangeFor(coin string) TimeRange {
+	if tr, ok := p.currentRanges[coin]; ok {
+		return tr
+	}
+
+	return p.LastTimeRange(coin)
+}
@@ -0,0 +1,108 @@
+package chart
+
+import "testing"
+
+func TestHandleMouseDisabled(t *testing.T) {
+	t.Setenv("JRDEV_CONFIG_DIR", t.TempDir())
+
+	chart := NewPriceChart(&fakeProvider{})
+	chart.EnableMouse = false
+
+	if err := chart.HandleMouse("bitcoin", MouseEvent{Button: MouseLeft, X: 3, Y: 4}); err != nil {
+		t.Fatalf("HandleMouse() error = %v", err)
+	}
+
+	if chart.ContextMenu != nil {
+		t.Error("ContextMenu was opened while EnableMouse is false")
+	}
+}
+
+func TestHandleMouseOpensContextMenu(t *testing.T) {
+	t.Setenv("JRDEV_CONFIG_DIR", t.TempDir())
+
+	chart := NewPriceChart(&fakeProvider{})
+
+	if err := chart.HandleMouse("bitcoin", MouseEvent{Button: MouseLeft, X: 3, Y: 4}); err != nil {
+		t.Fatalf("HandleMouse() error = %v", err)
+	}
+
+	if chart.ContextMenu == nil || chart.ContextMenu.Coin != "bitcoin" {
+		t.Fatalf("ContextMenu = %+v, want one opened for bitcoin", chart.ContextMenu)
+	}
+	if chart.ContextMenu.X != 3 || chart.ContextMenu.Y != 4 {
+		t.Errorf("ContextMenu anchored at (%d,%d), want (3,4)", chart.ContextMenu.X, chart.ContextMenu.Y)
+	}
+}
+
+func TestZoomInNarrowsRange(t *testing.T) {
+	t.Setenv("JRDEV_CONFIG_DIR", t.TempDir())
+
+	chart := NewPriceChart(&fakeProvider{})
+	chart.currentRanges["bitcoin"] = TimeRange{Preset: PresetCustom, Start: 1000, End: 2000}
+
+	if err := chart.Zoom("bitcoin", -1); err != nil {
+		t.Fatalf("Zoom(-1) error = %v", err)
+	}
+
+	got := chart.currentRanges["bitcoin"]
+	if width := got.End - got.Start; width >= 1000 {
+		t.Errorf("Zoom(-1) width = %d, want < 1000 (zooming in should narrow the range)", width)
+	}
+}
+
+func TestZoomOutWidensRange(t *testing.T) {
+	t.Setenv("JRDEV_CONFIG_DIR", t.TempDir())
+
+	chart := NewPriceChart(&fakeProvider{})
+	chart.currentRanges["bitcoin"] = TimeRange{Preset: PresetCustom, Start: 1000, End: 2000}
+
+	if err := chart.Zoom("bitcoin", 1); err != nil {
+		t.Fatalf("Zoom(1) error = %v", err)
+	}
+
+	got := chart.currentRanges["bitcoin"]
+	if width := got.End - got.Start; width <= 1000 {
+		t.Errorf("Zoom(1) width = %d, want > 1000 (zooming out should widen the range)", width)
+	}
+}
+
+func TestHandleKeyCyclesRange(t *testing.T) {
+	t.Setenv("JRDEV_CONFIG_DIR", t.TempDir())
+
+	chart := NewPriceChart(&fakeProvider{})
+	start := chart.TimeRangeDialog.Current().Preset
+
+	if err := chart.HandleKey("bitcoin", KeyNextRange); err != nil {
+		t.Fatalf("HandleKey(KeyNextRange) error = %v", err)
+	}
+	if got := chart.currentRanges["bitcoin"].Preset; got == start {
+		t.Errorf("HandleKey(KeyNextRange) did not advance the preset, still %v", got)
+	}
+
+	if err := chart.HandleKey("bitcoin", KeyPrevRange); err != nil {
+		t.Fatalf("HandleKey(KeyPrevRange) error = %v", err)
+	}
+	if got := chart.currentRanges["bitcoin"].Preset; got != start {
+		t.Errorf("HandleKey(KeyPrevRange) = %v, want back to %v", got, start)
+	}
+}
+
+func TestHandleKeyToggleMenu(t *testing.T) {
+	t.Setenv("JRDEV_CONFIG_DIR", t.TempDir())
+
+	chart := NewPriceChart(&fakeProvider{})
+
+	if err := chart.HandleKey("bitcoin", KeyToggleMenu); err != nil {
+		t.Fatalf("HandleKey() error = %v", err)
+	}
+	if chart.ContextMenu == nil {
+		t.Fatal("KeyToggleMenu did not open the context menu")
+	}
+
+	if err := chart.HandleKey("bitcoin", KeyToggleMenu); err != nil {
+		t.Fatalf("HandleKey() error = %v", err)
+	}
+	if chart.ContextMenu != nil {
+		t.Error("KeyToggleMenu did not close the context menu on the second press")
+	}
+}
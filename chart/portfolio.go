@@ -0,0 +1,128 @@
+package chart
+
+import (
+	"encoding/json"
+	"os"
+	"sort"
+	"time"
+
+	"github.com/presstab/jrdev/config"
+)
+
+const portfolioFileName = "portfolio.json"
+
+// Holding is a single portfolio entry: how much of a coin was bought, at
+// what price and in what currency, and when.
+type Holding struct {
+	Coin        string    `json:"coin"`
+	Holdings    float64   `json:"holdings"`
+	BuyPrice    float64   `json:"buy_price"`
+	BuyCurrency string    `json:"buy_currency"`
+	Timestamp   time.Time `json:"timestamp"`
+}
+
+// Cost returns what the holding was bought for: Holdings * BuyPrice.
+func (h Holding) Cost() float64 {
+	return h.Holdings * h.BuyPrice
+}
+
+// Portfolio is the set of holdings the user has recorded, keyed by coin
+// name, persisted to disk next to the rest of jrdev's config.
+type Portfolio struct {
+	Holdings map[string]Holding `json:"holdings"`
+
+	dirty bool
+}
+
+// NewPortfolio returns an empty Portfolio.
+func NewPortfolio() *Portfolio {
+	return &Portfolio{Holdings: make(map[string]Holding)}
+}
+
+// LoadPortfolio reads the persisted portfolio from the config directory.
+// A missing file is not an error; it yields an empty Portfolio.
+func LoadPortfolio() (*Portfolio, error) {
+	path, err := config.Path(portfolioFileName)
+	if err != nil {
+		return nil, err
+	}
+
+	raw, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return NewPortfolio(), nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	p := NewPortfolio()
+	if err := json.Unmarshal(raw, p); err != nil {
+		return nil, err
+	}
+
+	return p, nil
+}
+
+// Save writes the portfolio to the config directory if it has unsaved
+// changes.
+func (p *Portfolio) Save() error {
+	if !p.dirty {
+		return nil
+	}
+
+	path, err := config.Path(portfolioFileName)
+	if err != nil {
+		return err
+	}
+
+	raw, err := json.MarshalIndent(p, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	if err := os.WriteFile(path, raw, 0o644); err != nil {
+		return err
+	}
+
+	p.dirty = false
+	return nil
+}
+
+// PnL is the computed profit/loss of a Holding against its current
+// market value.
+type PnL struct {
+	Holding
+	MarketValue float64
+	Absolute    float64
+	Percent     float64
+}
+
+// SortKey selects the column a portfolio view is ordered by.
+type SortKey int
+
+const (
+	SortByBalance SortKey = iota
+	SortByCost
+	SortByPnL
+	SortByPnLPercent
+)
+
+// Sorted returns the PnL rows ordered by key, descending.
+func Sorted(rows []PnL, key SortKey) []PnL {
+	sorted := make([]PnL, len(rows))
+	copy(sorted, rows)
+
+	var less func(i, j int) bool
+	switch key {
+	case SortByCost:
+		less = func(i, j int) bool { return sorted[i].Cost() > sorted[j].Cost() }
+	case SortByPnL:
+		less = func(i, j int) bool { return sorted[i].Absolute > sorted[j].Absolute }
+	case SortByPnLPercent:
+		less = func(i, j int) bool { return sorted[i].Percent > sorted[j].Percent }
+	default: // SortByBalance
+		less = func(i, j int) bool { return sorted[i].Holdings > sorted[j].Holdings }
+	}
+
+	sort.Slice(sorted, less)
+	return sorted
+}
@@ -0,0 +1,268 @@
+// Package chart renders live cryptocurrency candles and portfolio
+// performance in the terminal.
+package chart
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/presstab/jrdev/config"
+	"github.com/presstab/jrdev/market"
+)
+
+// PriceChart represents a chart for cryptocurrency prices
+type PriceChart struct {
+	AssetBoxes      map[string]*AssetBox
+	ContextMenu     *ContextMenu
+	TimeRangeDialog *TimeRangeDialog
+	Provider        market.MarketDataProvider
+	Portfolio       *Portfolio
+
+	// MaxChartWidth is how many columns of candles an AssetBox renders;
+	// wider series are re-binned down to fit. Defaults to 175.
+	MaxChartWidth int
+
+	// EnableMouse mirrors cointop's enable_mouse config option. When
+	// false, HandleMouse and HandleDragSelect are no-ops and only the
+	// HandleKey keyboard path drives the chart.
+	EnableMouse bool
+
+	prices        map[string]float64
+	timeRanges    *timeRangeStore
+	currentRanges map[string]TimeRange
+}
+
+// NewPriceChart creates a new price chart instance, driven by provider
+// for live pricing and candle data.
+func NewPriceChart(provider market.MarketDataProvider) *PriceChart {
+	portfolio, err := LoadPortfolio()
+	if err != nil {
+		portfolio = NewPortfolio()
+	}
+
+	timeRanges, err := loadTimeRangeStore()
+	if err != nil {
+		timeRanges = &timeRangeStore{Ranges: make(map[string]TimeRange)}
+	}
+
+	settings, err := config.LoadSettings()
+	if err != nil {
+		settings = config.DefaultSettings()
+	}
+
+	return &PriceChart{
+		AssetBoxes:      make(map[string]*AssetBox),
+		ContextMenu:     nil,
+		TimeRangeDialog: NewTimeRangeDialog(),
+		Provider:        provider,
+		Portfolio:       portfolio,
+		MaxChartWidth:   defaultMaxChartWidth,
+		EnableMouse:     settings.EnableMouse,
+		prices:          make(map[string]float64),
+		timeRanges:      timeRanges,
+		currentRanges:   make(map[string]TimeRange),
+	}
+}
+
+// RefreshPrices pulls the latest snapshot prices from the provider.
+func (p *PriceChart) RefreshPrices() error {
+	coins, err := p.Provider.GetAllCoinData(0)
+	if err != nil {
+		return err
+	}
+
+	for _, coin := range coins {
+		p.prices[coin.Name] = coin.Price
+	}
+
+	return nil
+}
+
+// Price returns the last snapshot price fetched for coin by
+// RefreshPrices, or false if none has been fetched yet.
+func (p *PriceChart) Price(coin string) (float64, bool) {
+	price, ok := p.prices[coin]
+	return price, ok
+}
+
+// SetTimeRange refetches candles for coin over tr, re-bins them to fit
+// MaxChartWidth, and redraws the coin's AssetBox. The selected preset is
+// remembered so the same range is restored next session.
+func (p *PriceChart) SetTimeRange(coin string, tr TimeRange) error {
+	data, err := p.Provider.GetCoinGraphData(coin, coin, tr.Start, tr.End)
+	if err != nil {
+		return err
+	}
+
+	box, ok := p.AssetBoxes[coin]
+	if !ok {
+		box = NewAssetBox(coin)
+		p.AssetBoxes[coin] = box
+	}
+
+	box.Candles = rebinCandles(data.Candles, p.MaxChartWidth)
+	p.currentRanges[coin] = tr
+
+	p.timeRanges.set(coin, tr)
+
+	if holding, ok := p.Portfolio.Holdings[coin]; ok {
+		box.CostBasisLine = overlayCostBasis(box, holding.BuyPrice)
+	}
+
+	return nil
+}
+
+// LastTimeRange returns the range coin was last left on, defaulting to
+// 24H if it has never been set. A fixed preset is recomputed anchored
+// at now, so "24H" still means the last 24 hours after a restart;
+// PresetCustom has no fixed duration, so it is restored from its
+// literal Start/End instead.
+func (p *PriceChart) LastTimeRange(coin string) TimeRange {
+	tr, ok := p.timeRanges.Ranges[coin]
+	if !ok {
+		return NewPresetTimeRange(PresetTwentyFourHours, time.Now())
+	}
+
+	if tr.Preset == PresetCustom {
+		return tr
+	}
+
+	return NewPresetTimeRange(tr.Preset, time.Now())
+}
+
+// AddHolding records a purchase of a coin and recalculates its PnL
+// against the live price.
+func (p *PriceChart) AddHolding(coin string, holdings, buyPrice float64, buyCurrency string) {
+	p.Portfolio.Holdings[coin] = Holding{
+		Coin:        coin,
+		Holdings:    holdings,
+		BuyPrice:    buyPrice,
+		BuyCurrency: buyCurrency,
+		Timestamp:   time.Now(),
+	}
+	p.Portfolio.dirty = true
+
+	p.RecalcPnL(coin)
+}
+
+// RemoveHolding drops a coin from the portfolio.
+func (p *PriceChart) RemoveHolding(coin string) {
+	if _, ok := p.Portfolio.Holdings[coin]; !ok {
+		return
+	}
+
+	delete(p.Portfolio.Holdings, coin)
+	p.Portfolio.dirty = true
+
+	if box, ok := p.AssetBoxes[coin]; ok {
+		box.CostBasisLine = nil
+	}
+}
+
+// RecalcPnL recomputes cost, market value, and PnL for coin against the
+// last price fetched by RefreshPrices, and overlays the resulting
+// cost-basis line on its AssetBox. It is a no-op if the coin has no
+// holding or no live price yet.
+func (p *PriceChart) RecalcPnL(coin string) *PnL {
+	holding, ok := p.Portfolio.Holdings[coin]
+	if !ok {
+		return nil
+	}
+
+	price, ok := p.prices[coin]
+	if !ok {
+		return nil
+	}
+
+	marketValue := holding.Holdings * price
+	absolute := marketValue - holding.Cost()
+	percent := 0.0
+	if holding.Cost() != 0 {
+		percent = absolute / holding.Cost() * 100
+	}
+
+	pnl := &PnL{
+		Holding:     holding,
+		MarketValue: marketValue,
+		Absolute:    absolute,
+		Percent:     percent,
+	}
+
+	if box, ok := p.AssetBoxes[coin]; ok {
+		box.CostBasisLine = overlayCostBasis(box, holding.BuyPrice)
+	}
+
+	return pnl
+}
+
+// overlayCostBasis returns a flat line at buyPrice spanning the width of
+// box's currently rendered candles, so it can be drawn over the chart
+// area as the holder's break-even reference.
+func overlayCostBasis(box *AssetBox, buyPrice float64) []float64 {
+	width := len(box.Candles)
+	if width == 0 {
+		width = 1
+	}
+
+	line := make([]float64, width)
+	for i := range line {
+		line[i] = buyPrice
+	}
+
+	return line
+}
+
+// PortfolioView returns the PnL of every holding that has a price from
+// RefreshPrices, ordered by key. Holdings with no price are left out.
+func (p *PriceChart) PortfolioView(key SortKey) []PnL {
+	rows := make([]PnL, 0, len(p.Portfolio.Holdings))
+	for coin := range p.Portfolio.Holdings {
+		if pnl := p.RecalcPnL(coin); pnl != nil {
+			rows = append(rows, *pnl)
+		}
+	}
+
+	return Sorted(rows, key)
+}
+
+// Cleanup releases resources used by the price chart
+func (p *PriceChart) Cleanup() {
+	// Flush any unsaved portfolio state before tearing down.
+	if p.Portfolio != nil {
+		if err := p.Portfolio.Save(); err != nil {
+			fmt.Println("jrdev: failed to save portfolio:", err)
+		}
+	}
+
+	if p.timeRanges != nil {
+		if err := p.timeRanges.save(); err != nil {
+			fmt.Println("jrdev: failed to save time ranges:", err)
+		}
+	}
+
+	// Clean up asset boxes
+	for coinName, assetBox := range p.AssetBoxes {
+		assetBox.Dispose()
+		delete(p.AssetBoxes, coinName)
+	}
+
+	// Clean up context menu
+	if p.ContextMenu != nil {
+		p.ContextMenu.Dispose()
+		p.ContextMenu = nil
+	}
+
+	// Clean up time range dialog
+	if p.TimeRangeDialog != nil {
+		p.TimeRangeDialog.Dispose()
+		p.TimeRangeDialog = nil
+	}
+
+	// Note: Provider is shared across windows, don't tear it down here
+}
+
+// Check performs validation of the chart
+func (p *PriceChart) Check() {
+	// TODO: Add implementation details here later
+	fmt.Println("Checking price chart...")
+}
@@ -0,0 +1,72 @@
+package chart
+
+import (
+	"testing"
+
+	"github.com/presstab/jrdev/market"
+)
+
+// fakeProvider is an in-memory MarketDataProvider so tests can drive
+// PriceChart without hitting the network.
+type fakeProvider struct {
+	coins []market.CoinData
+}
+
+func (f *fakeProvider) GetAllCoinData(limit int) ([]market.CoinData, error) {
+	if limit > 0 && limit < len(f.coins) {
+		return f.coins[:limit], nil
+	}
+	return f.coins, nil
+}
+
+func (f *fakeProvider) GetCoinGraphData(symbol, name string, start, end int64) (market.GraphData, error) {
+	return market.GraphData{Coin: name}, nil
+}
+
+func (f *fakeProvider) GetGlobalMarketData() (market.GlobalMarketData, error) {
+	return market.GlobalMarketData{}, nil
+}
+
+func (f *fakeProvider) Ping() error { return nil }
+
+func TestRecalcPnL(t *testing.T) {
+	t.Setenv("JRDEV_CONFIG_DIR", t.TempDir())
+
+	provider := &fakeProvider{coins: []market.CoinData{{Symbol: "btc", Name: "bitcoin", Price: 150}}}
+
+	chart := NewPriceChart(provider)
+	chart.AssetBoxes["bitcoin"] = NewAssetBox("bitcoin")
+	chart.Portfolio.Holdings["bitcoin"] = Holding{Coin: "bitcoin", Holdings: 2, BuyPrice: 100}
+
+	if err := chart.RefreshPrices(); err != nil {
+		t.Fatalf("RefreshPrices() error = %v", err)
+	}
+
+	pnl := chart.RecalcPnL("bitcoin")
+	if pnl == nil {
+		t.Fatal("RecalcPnL() = nil, want a PnL")
+	}
+
+	if want := 100.0; pnl.Absolute != want {
+		t.Errorf("Absolute = %v, want %v", pnl.Absolute, want)
+	}
+
+	if want := 50.0; pnl.Percent != want {
+		t.Errorf("Percent = %v, want %v", pnl.Percent, want)
+	}
+
+	box := chart.AssetBoxes["bitcoin"]
+	if len(box.CostBasisLine) == 0 {
+		t.Error("CostBasisLine was not overlaid onto the AssetBox")
+	}
+}
+
+func TestRecalcPnLNoHolding(t *testing.T) {
+	t.Setenv("JRDEV_CONFIG_DIR", t.TempDir())
+
+	chart := NewPriceChart(&fakeProvider{})
+
+	if pnl := chart.RecalcPnL("bitcoin"); pnl != nil {
+		t.Errorf("RecalcPnL() = %v, want nil for an unheld coin", pnl)
+	}
+}
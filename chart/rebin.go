@@ -0,0 +1,57 @@
+package chart
+
+import "github.com/presstab/jrdev/market"
+
+// defaultMaxChartWidth is how many columns an AssetBox renders by
+// default; wider candle series are re-binned down to fit.
+const defaultMaxChartWidth = 175
+
+// rebinCandles aggregates candles down to at most maxWidth samples,
+// combining each bucket into a single OHLCV candle so the series still
+// fits the chart area regardless of the requested time range.
+func rebinCandles(candles []market.Candle, maxWidth int) []market.Candle {
+	if maxWidth <= 0 {
+		maxWidth = defaultMaxChartWidth
+	}
+
+	if len(candles) <= maxWidth {
+		return candles
+	}
+
+	bucketSize := (len(candles) + maxWidth - 1) / maxWidth
+	rebinned := make([]market.Candle, 0, maxWidth)
+
+	for start := 0; start < len(candles); start += bucketSize {
+		end := start + bucketSize
+		if end > len(candles) {
+			end = len(candles)
+		}
+
+		rebinned = append(rebinned, mergeCandles(candles[start:end]))
+	}
+
+	return rebinned
+}
+
+// mergeCandles combines a contiguous run of candles into one.
+func mergeCandles(bucket []market.Candle) market.Candle {
+	merged := market.Candle{
+		Time: bucket[0].Time,
+		Open: bucket[0].Open,
+		High: bucket[0].High,
+		Low:  bucket[0].Low,
+	}
+
+	for _, c := range bucket {
+		if c.High > merged.High {
+			merged.High = c.High
+		}
+		if c.Low < merged.Low {
+			merged.Low = c.Low
+		}
+		merged.Volume += c.Volume
+	}
+
+	merged.Close = bucket[len(bucket)-1].Close
+	return merged
+}
@@ -0,0 +1,44 @@
+package chart
+
+import (
+	"testing"
+
+	"github.com/presstab/jrdev/market"
+)
+
+func TestRebinCandlesUnderWidth(t *testing.T) {
+	candles := make([]market.Candle, 10)
+	if got := rebinCandles(candles, 175); len(got) != 10 {
+		t.Errorf("len(rebinCandles) = %d, want 10 (no rebinning needed)", len(got))
+	}
+}
+
+func TestRebinCandlesOverWidth(t *testing.T) {
+	candles := make([]market.Candle, 350)
+	for i := range candles {
+		candles[i] = market.Candle{Time: int64(i), Open: 1, High: float64(i + 1), Low: 0, Close: 1}
+	}
+
+	got := rebinCandles(candles, 175)
+	if len(got) > 175 {
+		t.Errorf("len(rebinCandles) = %d, want <= 175", len(got))
+	}
+
+	last := got[len(got)-1]
+	if last.High != 350 {
+		t.Errorf("last bucket High = %v, want 350 (max of its members)", last.High)
+	}
+}
+
+func TestTimeRangeDialogCycle(t *testing.T) {
+	d := NewTimeRangeDialog()
+	start := d.Current().Preset
+
+	for range d.Presets {
+		d.Next()
+	}
+
+	if got := d.Current().Preset; got != start {
+		t.Errorf("after a full cycle of Next(), preset = %v, want %v", got, start)
+	}
+}
@@ -0,0 +1,96 @@
+package chart
+
+import "time"
+
+// Preset is one of the fixed windows a TimeRangeDialog can select, or
+// PresetCustom for an explicit start/end chosen by the user.
+type Preset int
+
+const (
+	PresetOneHour Preset = iota
+	PresetTwentyFourHours
+	PresetSevenDays
+	PresetThirtyDays
+	PresetThreeMonths
+	PresetOneYear
+	PresetAll
+	PresetCustom
+)
+
+// String returns the label shown in the dialog, e.g. "24H".
+func (p Preset) String() string {
+	switch p {
+	case PresetOneHour:
+		return "1H"
+	case PresetTwentyFourHours:
+		return "24H"
+	case PresetSevenDays:
+		return "7D"
+	case PresetThirtyDays:
+		return "30D"
+	case PresetThreeMonths:
+		return "3M"
+	case PresetOneYear:
+		return "1Y"
+	case PresetAll:
+		return "All"
+	default:
+		return "Custom"
+	}
+}
+
+// presetDurations is how far back each fixed preset looks from now.
+// PresetAll and PresetCustom are handled separately since they aren't a
+// fixed duration.
+var presetDurations = map[Preset]time.Duration{
+	PresetOneHour:         time.Hour,
+	PresetTwentyFourHours: 24 * time.Hour,
+	PresetSevenDays:       7 * 24 * time.Hour,
+	PresetThirtyDays:      30 * 24 * time.Hour,
+	PresetThreeMonths:     90 * 24 * time.Hour,
+	PresetOneYear:         365 * 24 * time.Hour,
+}
+
+// presetOrder is the cycling order next/previous keybindings step
+// through; PresetCustom is reached only by an explicit selection.
+var presetOrder = []Preset{
+	PresetOneHour,
+	PresetTwentyFourHours,
+	PresetSevenDays,
+	PresetThirtyDays,
+	PresetThreeMonths,
+	PresetOneYear,
+	PresetAll,
+}
+
+// TimeRange is the window of candles a PriceChart should fetch and
+// render for a coin.
+type TimeRange struct {
+	Preset Preset `json:"preset"`
+	Start  int64  `json:"start"` // unix seconds
+	End    int64  `json:"end"`   // unix seconds
+}
+
+// NewPresetTimeRange returns the TimeRange for preset, anchored at now.
+func NewPresetTimeRange(preset Preset, now time.Time) TimeRange {
+	end := now.Unix()
+
+	if preset == PresetAll {
+		return TimeRange{Preset: preset, Start: 0, End: end}
+	}
+
+	duration, ok := presetDurations[preset]
+	if !ok {
+		// PresetCustom has no fixed duration; callers build it via
+		// NewCustomTimeRange instead.
+		return TimeRange{Preset: preset, Start: end, End: end}
+	}
+
+	return TimeRange{Preset: preset, Start: now.Add(-duration).Unix(), End: end}
+}
+
+// NewCustomTimeRange returns an explicit TimeRange between start and
+// end, given as Unix seconds.
+func NewCustomTimeRange(start, end int64) TimeRange {
+	return TimeRange{Preset: PresetCustom, Start: start, End: end}
+}
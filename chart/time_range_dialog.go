@@ -0,0 +1,53 @@
+package chart
+
+import "time"
+
+// TimeRangeDialog lets the user pick the window of candles an AssetBox
+// displays, either from a fixed preset or an explicit custom window.
+type TimeRangeDialog struct {
+	// Presets is the cycling order next/previous keybindings step
+	// through.
+	Presets []Preset
+
+	selected int
+	disposed bool
+}
+
+// NewTimeRangeDialog creates a TimeRangeDialog defaulted to the 24H
+// preset.
+func NewTimeRangeDialog() *TimeRangeDialog {
+	return &TimeRangeDialog{
+		Presets:  presetOrder,
+		selected: 1, // PresetTwentyFourHours
+	}
+}
+
+// Current returns the TimeRange for whichever preset is selected.
+func (t *TimeRangeDialog) Current() TimeRange {
+	return NewPresetTimeRange(t.Presets[t.selected], time.Now())
+}
+
+// Next selects the following preset, wrapping around at the end, and
+// returns the resulting TimeRange.
+func (t *TimeRangeDialog) Next() TimeRange {
+	t.selected = (t.selected + 1) % len(t.Presets)
+	return t.Current()
+}
+
+// Previous selects the preceding preset, wrapping around at the start,
+// and returns the resulting TimeRange.
+func (t *TimeRangeDialog) Previous() TimeRange {
+	t.selected = (t.selected - 1 + len(t.Presets)) % len(t.Presets)
+	return t.Current()
+}
+
+// Custom returns an explicit start/end window, given as Unix seconds.
+// The selected preset, and so what Current returns, is left unchanged.
+func (t *TimeRangeDialog) Custom(start, end int64) TimeRange {
+	return NewCustomTimeRange(start, end)
+}
+
+// Dispose releases resources held by the dialog.
+func (t *TimeRangeDialog) Dispose() {
+	t.disposed = true
+}
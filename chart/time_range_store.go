@@ -0,0 +1,75 @@
+package chart
+
+import (
+	"encoding/json"
+	"os"
+
+	"github.com/presstab/jrdev/config"
+)
+
+const timeRangeFileName = "time_ranges.json"
+
+// timeRangeStore persists the last range selected per coin, so a
+// restarted jrdev reopens each chart on the range the user left it at.
+// The full TimeRange, not just its Preset, is stored: a fixed preset
+// (24H, 7D, ...) is recomputed fresh from the stored Preset so it still
+// means "last N" after a restart, while PresetCustom - which has no
+// fixed duration - is restored from its literal Start/End instead of
+// collapsing to a zero-width window.
+type timeRangeStore struct {
+	Ranges map[string]TimeRange `json:"ranges"`
+
+	dirty bool
+}
+
+func loadTimeRangeStore() (*timeRangeStore, error) {
+	path, err := config.Path(timeRangeFileName)
+	if err != nil {
+		return nil, err
+	}
+
+	raw, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return &timeRangeStore{Ranges: make(map[string]TimeRange)}, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	store := &timeRangeStore{}
+	if err := json.Unmarshal(raw, store); err != nil {
+		return nil, err
+	}
+	if store.Ranges == nil {
+		store.Ranges = make(map[string]TimeRange)
+	}
+
+	return store, nil
+}
+
+func (s *timeRangeStore) set(coin string, tr TimeRange) {
+	s.Ranges[coin] = tr
+	s.dirty = true
+}
+
+func (s *timeRangeStore) save() error {
+	if !s.dirty {
+		return nil
+	}
+
+	path, err := config.Path(timeRangeFileName)
+	if err != nil {
+		return err
+	}
+
+	raw, err := json.MarshalIndent(s, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	if err := os.WriteFile(path, raw, 0o644); err != nil {
+		return err
+	}
+
+	s.dirty = false
+	return nil
+}
@@ -0,0 +1,29 @@
+package chart
+
+import "testing"
+
+func TestLastTimeRangeRestoresCustomWindow(t *testing.T) {
+	t.Setenv("JRDEV_CONFIG_DIR", t.TempDir())
+
+	chart := NewPriceChart(&fakeProvider{})
+	custom := NewCustomTimeRange(1000, 2000)
+
+	if err := chart.SetTimeRange("bitcoin", custom); err != nil {
+		t.Fatalf("SetTimeRange() error = %v", err)
+	}
+	if err := chart.timeRanges.save(); err != nil {
+		t.Fatalf("save() error = %v", err)
+	}
+
+	restored, err := loadTimeRangeStore()
+	if err != nil {
+		t.Fatalf("loadTimeRangeStore() error = %v", err)
+	}
+
+	reloaded := &PriceChart{timeRanges: restored}
+	got := reloaded.LastTimeRange("bitcoin")
+
+	if got.Start != custom.Start || got.End != custom.End {
+		t.Errorf("LastTimeRange() = %+v, want literal %+v restored, not a recomputed window", got, custom)
+	}
+}
@@ -0,0 +1,134 @@
+// Command jrdev is the interactive TUI's non-interactive CLI
+// counterpart: price and holdings subcommands for scripting and cron
+// use cases (price alerts, daily PnL emails) that don't need the chart.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/presstab/jrdev/asciitable"
+	"github.com/presstab/jrdev/chart"
+	"github.com/presstab/jrdev/config"
+	"github.com/presstab/jrdev/market"
+)
+
+func main() {
+	if len(os.Args) < 2 {
+		usage()
+		os.Exit(1)
+	}
+
+	provider, err := newProvider()
+	if err == nil {
+		switch os.Args[1] {
+		case "price":
+			err = runPrice(os.Args[2:], provider, os.Stdout)
+		case "holdings":
+			err = runHoldings(os.Args[2:], provider, os.Stdout)
+		default:
+			usage()
+			os.Exit(1)
+		}
+	}
+
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "jrdev:", err)
+		os.Exit(1)
+	}
+}
+
+func usage() {
+	fmt.Fprintln(os.Stderr, "usage: jrdev <price|holdings> [flags]")
+}
+
+// newProvider builds the MarketDataProvider configured in the main
+// settings file, for runPrice/runHoldings to be injected with.
+func newProvider() (market.MarketDataProvider, error) {
+	settings, err := config.LoadSettings()
+	if err != nil {
+		settings = config.DefaultSettings()
+	}
+
+	dir, err := config.Dir()
+	if err != nil {
+		return nil, err
+	}
+
+	return market.FromSettings(market.ProviderSettings{
+		Provider:  settings.Provider,
+		APIKey:    settings.APIKey,
+		APIKeyPro: settings.APIKeyPro,
+		CacheTTL:  settings.CacheTTL(),
+	}, dir)
+}
+
+func runPrice(args []string, provider market.MarketDataProvider, out io.Writer) error {
+	fs := flag.NewFlagSet("price", flag.ExitOnError)
+	coin := fs.String("coin", "", "coin id, e.g. bitcoin")
+	currency := fs.String("currency", "USD", "quote currency")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	if *coin == "" {
+		return fmt.Errorf("price: --coin is required")
+	}
+
+	// GetAllCoinData always fetches USD: CoinGecko and CoinMarketCap
+	// both hardcode it, so reject anything else rather than printing a
+	// USD number mislabeled with the requested currency.
+	if strings.ToUpper(*currency) != "USD" {
+		return fmt.Errorf("price: only --currency USD is supported")
+	}
+
+	pc := chart.NewPriceChart(provider)
+	if err := pc.RefreshPrices(); err != nil {
+		return fmt.Errorf("price: %w", err)
+	}
+
+	price, ok := pc.Price(*coin)
+	if !ok {
+		return fmt.Errorf("price: no price found for %s", *coin)
+	}
+
+	fmt.Fprintf(out, "%s: %.2f %s\n", *coin, price, strings.ToUpper(*currency))
+	return nil
+}
+
+func runHoldings(args []string, provider market.MarketDataProvider, out io.Writer) error {
+	fs := flag.NewFlagSet("holdings", flag.ExitOnError)
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	pc := chart.NewPriceChart(provider)
+	if err := pc.RefreshPrices(); err != nil {
+		return fmt.Errorf("holdings: %w", err)
+	}
+
+	rows := pc.PortfolioView(chart.SortByBalance)
+	if len(rows) == 0 {
+		fmt.Fprintln(out, "no holdings recorded")
+		return nil
+	}
+
+	table := asciitable.New([]string{"Coin", "Holdings", "Buy Price", "Cost", "Value", "PnL", "PnL %"})
+	for _, r := range rows {
+		table.AddRow(
+			r.Coin,
+			fmt.Sprintf("%.8f", r.Holdings),
+			fmt.Sprintf("%.2f", r.BuyPrice),
+			fmt.Sprintf("%.2f", r.Cost()),
+			fmt.Sprintf("%.2f", r.MarketValue),
+			fmt.Sprintf("%.2f", r.Absolute),
+			fmt.Sprintf("%.2f%%", r.Percent),
+		)
+	}
+
+	fmt.Fprint(out, table.Render())
+	return nil
+}
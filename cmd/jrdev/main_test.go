@@ -0,0 +1,95 @@
+package main
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/presstab/jrdev/market"
+)
+
+// fakeProvider is an in-memory market.MarketDataProvider, mirroring
+// chart/price_chart_test.go's test double, so runPrice/runHoldings can
+// be exercised without hitting the network.
+type fakeProvider struct {
+	coins []market.CoinData
+}
+
+func (f *fakeProvider) GetAllCoinData(limit int) ([]market.CoinData, error) {
+	if limit > 0 && limit < len(f.coins) {
+		return f.coins[:limit], nil
+	}
+	return f.coins, nil
+}
+
+func (f *fakeProvider) GetCoinGraphData(symbol, name string, start, end int64) (market.GraphData, error) {
+	return market.GraphData{Coin: name}, nil
+}
+
+func (f *fakeProvider) GetGlobalMarketData() (market.GlobalMarketData, error) {
+	return market.GlobalMarketData{}, nil
+}
+
+func (f *fakeProvider) Ping() error { return nil }
+
+func TestRunPricePrintsUSD(t *testing.T) {
+	t.Setenv("JRDEV_CONFIG_DIR", t.TempDir())
+
+	provider := &fakeProvider{coins: []market.CoinData{{Symbol: "btc", Name: "bitcoin", Price: 42000}}}
+	var out bytes.Buffer
+
+	if err := runPrice([]string{"--coin", "bitcoin"}, provider, &out); err != nil {
+		t.Fatalf("runPrice() error = %v", err)
+	}
+
+	if want := "bitcoin: 42000.00 USD\n"; out.String() != want {
+		t.Errorf("runPrice() output = %q, want %q", out.String(), want)
+	}
+}
+
+func TestRunPriceRejectsNonUSDCurrency(t *testing.T) {
+	t.Setenv("JRDEV_CONFIG_DIR", t.TempDir())
+
+	provider := &fakeProvider{coins: []market.CoinData{{Symbol: "btc", Name: "bitcoin", Price: 42000}}}
+	var out bytes.Buffer
+
+	err := runPrice([]string{"--coin", "bitcoin", "--currency", "EUR"}, provider, &out)
+	if err == nil {
+		t.Fatal("runPrice() error = nil, want an error for --currency EUR")
+	}
+	if !strings.Contains(err.Error(), "USD") {
+		t.Errorf("runPrice() error = %v, want it to mention USD", err)
+	}
+}
+
+func TestRunPriceMissingCoin(t *testing.T) {
+	t.Setenv("JRDEV_CONFIG_DIR", t.TempDir())
+
+	var out bytes.Buffer
+	if err := runPrice(nil, &fakeProvider{}, &out); err == nil {
+		t.Fatal("runPrice() error = nil, want an error when --coin is missing")
+	}
+}
+
+func TestRunPriceNoMatch(t *testing.T) {
+	t.Setenv("JRDEV_CONFIG_DIR", t.TempDir())
+
+	var out bytes.Buffer
+	err := runPrice([]string{"--coin", "doesnotexist"}, &fakeProvider{}, &out)
+	if err == nil {
+		t.Fatal("runPrice() error = nil, want an error for an unknown coin")
+	}
+}
+
+func TestRunHoldingsNoHoldings(t *testing.T) {
+	t.Setenv("JRDEV_CONFIG_DIR", t.TempDir())
+
+	var out bytes.Buffer
+	if err := runHoldings(nil, &fakeProvider{}, &out); err != nil {
+		t.Fatalf("runHoldings() error = %v", err)
+	}
+
+	if want := "no holdings recorded\n"; out.String() != want {
+		t.Errorf("runHoldings() output = %q, want %q", out.String(), want)
+	}
+}
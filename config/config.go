@@ -0,0 +1,43 @@
+// Package config resolves the on-disk locations jrdev uses for its
+// configuration and cached/persisted state.
+package config
+
+import (
+	"os"
+	"path/filepath"
+)
+
+const dirName = ".jrdev"
+
+// dirEnvVar overrides the config directory when set, so tests can point
+// jrdev at a throwaway directory instead of the real home directory.
+const dirEnvVar = "JRDEV_CONFIG_DIR"
+
+// Dir returns the directory jrdev stores its config and state in,
+// creating it if it does not already exist.
+func Dir() (string, error) {
+	dir := os.Getenv(dirEnvVar)
+	if dir == "" {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return "", err
+		}
+		dir = filepath.Join(home, dirName)
+	}
+
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return "", err
+	}
+
+	return dir, nil
+}
+
+// Path joins name onto the jrdev config directory.
+func Path(name string) (string, error) {
+	dir, err := Dir()
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Join(dir, name), nil
+}
@@ -0,0 +1,93 @@
+package config
+
+import (
+	"encoding/json"
+	"os"
+	"time"
+)
+
+const settingsFileName = "config.json"
+
+// Settings is jrdev's main config file, analogous to cointop's
+// config.toml.
+type Settings struct {
+	// EnableMouse mirrors cointop's enable_mouse: whether PriceChart
+	// wires up click, scroll, and drag handling, or falls back to the
+	// keyboard-only path.
+	EnableMouse bool `json:"enable_mouse"`
+
+	// Provider selects the MarketDataProvider backend: "coingecko"
+	// (the default) or "coinmarketcap".
+	Provider string `json:"provider"`
+
+	// APIKey is sent to Provider's API, if it needs one. CoinGecko works
+	// without one on its free, rate-limited tier; CoinMarketCap always
+	// requires one.
+	APIKey string `json:"api_key"`
+
+	// APIKeyPro marks APIKey as a CoinGecko pro key rather than a demo
+	// key. It has no effect for CoinMarketCap.
+	APIKeyPro bool `json:"api_key_pro"`
+
+	// CacheTTLSeconds is how long a cached graph data response is
+	// considered fresh. 0 falls back to DefaultCacheTTL.
+	CacheTTLSeconds int `json:"cache_ttl_seconds"`
+}
+
+// DefaultCacheTTL is used when CacheTTLSeconds is unset.
+const DefaultCacheTTL = 5 * time.Minute
+
+// DefaultSettings is what a fresh install of jrdev starts with.
+func DefaultSettings() Settings {
+	return Settings{
+		EnableMouse: true,
+		Provider:    "coingecko",
+	}
+}
+
+// CacheTTL returns CacheTTLSeconds as a time.Duration, falling back to
+// DefaultCacheTTL when unset.
+func (s Settings) CacheTTL() time.Duration {
+	if s.CacheTTLSeconds <= 0 {
+		return DefaultCacheTTL
+	}
+	return time.Duration(s.CacheTTLSeconds) * time.Second
+}
+
+// LoadSettings reads the main config file, falling back to
+// DefaultSettings if it does not exist yet.
+func LoadSettings() (Settings, error) {
+	path, err := Path(settingsFileName)
+	if err != nil {
+		return Settings{}, err
+	}
+
+	raw, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return DefaultSettings(), nil
+	} else if err != nil {
+		return Settings{}, err
+	}
+
+	settings := DefaultSettings()
+	if err := json.Unmarshal(raw, &settings); err != nil {
+		return Settings{}, err
+	}
+
+	return settings, nil
+}
+
+// Save writes the settings to the main config file.
+func (s Settings) Save() error {
+	path, err := Path(settingsFileName)
+	if err != nil {
+		return err
+	}
+
+	raw, err := json.MarshalIndent(s, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(path, raw, 0o644)
+}
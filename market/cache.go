@@ -0,0 +1,121 @@
+package market
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/gob"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+// schemaSignature gob-encodes a fully-populated GraphData so the wire
+// type descriptor - which carries every field name - is captured. It is
+// combined into each cache key so that a jrdev upgrade which adds,
+// removes, or renames a GraphData field invalidates every entry written
+// by the old schema instead of serving a half-decoded stale one.
+var schemaSignature = sync.OnceValue(func() []byte {
+	buf := &bytes.Buffer{}
+	sample := GraphData{
+		Coin: "x",
+		Candles: []Candle{
+			{Time: 1, Open: 1, High: 1, Low: 1, Close: 1, Volume: 1},
+		},
+	}
+	// Encoding errors here would mean GraphData itself is not
+	// gob-encodable, which is a programmer error, not a runtime one.
+	if err := gob.NewEncoder(buf).Encode(sample); err != nil {
+		panic(fmt.Sprintf("market: GraphData is not gob-encodable: %v", err))
+	}
+
+	return buf.Bytes()
+})
+
+// CachingProvider wraps a MarketDataProvider with an on-disk cache of
+// graph data, keyed by a hash of the request plus the GraphData schema
+// signature.
+type CachingProvider struct {
+	MarketDataProvider
+
+	dir string
+	ttl time.Duration
+}
+
+// NewCachingProvider wraps provider with a cache that stores entries
+// under dir and considers them fresh for ttl.
+func NewCachingProvider(provider MarketDataProvider, dir string, ttl time.Duration) (*CachingProvider, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, err
+	}
+
+	return &CachingProvider{MarketDataProvider: provider, dir: dir, ttl: ttl}, nil
+}
+
+type cacheEntry struct {
+	StoredAt time.Time
+	Data     GraphData
+}
+
+func (c *CachingProvider) cacheKey(symbol, name string, start, end int64) string {
+	h := sha256.New()
+	h.Write(schemaSignature())
+	fmt.Fprintf(h, "%s|%s|%d|%d", symbol, name, start, end)
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+func (c *CachingProvider) cachePath(key string) string {
+	return filepath.Join(c.dir, key+".json")
+}
+
+// GetCoinGraphData implements MarketDataProvider, serving from cache
+// when a fresh entry exists and falling back to the wrapped provider
+// otherwise.
+func (c *CachingProvider) GetCoinGraphData(symbol, name string, start, end int64) (GraphData, error) {
+	key := c.cacheKey(symbol, name, start, end)
+	path := c.cachePath(key)
+
+	if entry, ok := c.read(path); ok {
+		return entry.Data, nil
+	}
+
+	data, err := c.MarketDataProvider.GetCoinGraphData(symbol, name, start, end)
+	if err != nil {
+		return GraphData{}, err
+	}
+
+	c.write(path, cacheEntry{StoredAt: time.Now(), Data: data})
+	return data, nil
+}
+
+func (c *CachingProvider) read(path string) (cacheEntry, bool) {
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		return cacheEntry{}, false
+	}
+
+	var entry cacheEntry
+	if err := json.Unmarshal(raw, &entry); err != nil {
+		return cacheEntry{}, false
+	}
+
+	if time.Since(entry.StoredAt) > c.ttl {
+		return cacheEntry{}, false
+	}
+
+	return entry, true
+}
+
+func (c *CachingProvider) write(path string, entry cacheEntry) {
+	raw, err := json.Marshal(entry)
+	if err != nil {
+		return
+	}
+
+	// Best-effort: a failed cache write should never fail the caller,
+	// it just means the next request misses the cache too.
+	_ = os.WriteFile(path, raw, 0o644)
+}
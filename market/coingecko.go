@@ -0,0 +1,231 @@
+package market
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+const (
+	coinGeckoFreeBaseURL = "https://api.coingecko.com/api/v3"
+	coinGeckoProBaseURL  = "https://pro-api.coingecko.com/api/v3"
+)
+
+// CoinGecko is a MarketDataProvider backed by the CoinGecko API. An
+// empty APIKey uses the free, rate-limited tier; a non-empty one is
+// sent as a demo or pro key depending on Pro.
+type CoinGecko struct {
+	APIKey string
+	Pro    bool
+
+	// BaseURL overrides the API host, for pointing tests at a fake
+	// server. Empty uses the real CoinGecko endpoints.
+	BaseURL string
+
+	httpClient *http.Client
+}
+
+// NewCoinGecko returns a CoinGecko provider. apiKey may be empty to use
+// the public tier.
+func NewCoinGecko(apiKey string, pro bool) *CoinGecko {
+	return &CoinGecko{
+		APIKey:     apiKey,
+		Pro:        pro,
+		httpClient: &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+func (c *CoinGecko) baseURL() string {
+	if c.BaseURL != "" {
+		return c.BaseURL
+	}
+	if c.Pro {
+		return coinGeckoProBaseURL
+	}
+	return coinGeckoFreeBaseURL
+}
+
+func (c *CoinGecko) newRequest(url string) (*http.Request, error) {
+	req, err := http.NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if c.APIKey == "" {
+		return req, nil
+	}
+
+	if c.Pro {
+		req.Header.Set("x-cg-pro-api-key", c.APIKey)
+	} else {
+		req.Header.Set("x-cg-demo-api-key", c.APIKey)
+	}
+
+	return req, nil
+}
+
+// coinGeckoPerPage is the largest page size CoinGecko's /coins/markets
+// accepts.
+const coinGeckoPerPage = 250
+
+// coinGeckoMaxPages bounds how many pages GetAllCoinData(0) will walk,
+// comfortably above CoinGecko's total listed coin count, so a listing
+// endpoint that never ends can't loop forever.
+const coinGeckoMaxPages = 80
+
+// GetAllCoinData implements MarketDataProvider. A limit of 0 paginates
+// through every page CoinGecko returns instead of stopping at the first
+// one, so callers looking up a coin outside the top 250 by market cap
+// still find it.
+func (c *CoinGecko) GetAllCoinData(limit int) ([]CoinData, error) {
+	var coins []CoinData
+
+	for page := 1; page <= coinGeckoMaxPages; page++ {
+		pageCoins, err := c.fetchCoinsPage(page, coinGeckoPerPage)
+		if err != nil {
+			return nil, err
+		}
+
+		coins = append(coins, pageCoins...)
+		if limit > 0 && len(coins) >= limit {
+			return coins[:limit], nil
+		}
+
+		if len(pageCoins) < coinGeckoPerPage {
+			// Fewer coins than a full page means we've reached the end
+			// of CoinGecko's list.
+			break
+		}
+	}
+
+	return coins, nil
+}
+
+func (c *CoinGecko) fetchCoinsPage(page, perPage int) ([]CoinData, error) {
+	url := fmt.Sprintf("%s/coins/markets?vs_currency=usd&order=market_cap_desc&per_page=%d&page=%d", c.baseURL(), perPage, page)
+	req, err := c.newRequest(url)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("coingecko: unexpected status %s", resp.Status)
+	}
+
+	var raw []struct {
+		Symbol       string  `json:"symbol"`
+		Name         string  `json:"id"`
+		CurrentPrice float64 `json:"current_price"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&raw); err != nil {
+		return nil, err
+	}
+
+	coins := make([]CoinData, 0, len(raw))
+	for _, r := range raw {
+		coins = append(coins, CoinData{Symbol: r.Symbol, Name: r.Name, Price: r.CurrentPrice})
+	}
+
+	return coins, nil
+}
+
+// GetCoinGraphData implements MarketDataProvider.
+func (c *CoinGecko) GetCoinGraphData(symbol, name string, start, end int64) (GraphData, error) {
+	url := fmt.Sprintf("%s/coins/%s/market_chart/range?vs_currency=usd&from=%d&to=%d", c.baseURL(), name, start, end)
+	req, err := c.newRequest(url)
+	if err != nil {
+		return GraphData{}, err
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return GraphData{}, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return GraphData{}, fmt.Errorf("coingecko: unexpected status %s", resp.Status)
+	}
+
+	var raw struct {
+		Prices [][2]float64 `json:"prices"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&raw); err != nil {
+		return GraphData{}, err
+	}
+
+	candles := make([]Candle, 0, len(raw.Prices))
+	for _, p := range raw.Prices {
+		candles = append(candles, Candle{
+			Time:  int64(p[0]) / 1000,
+			Open:  p[1],
+			High:  p[1],
+			Low:   p[1],
+			Close: p[1],
+		})
+	}
+
+	return GraphData{Coin: name, Candles: candles}, nil
+}
+
+// GetGlobalMarketData implements MarketDataProvider.
+func (c *CoinGecko) GetGlobalMarketData() (GlobalMarketData, error) {
+	req, err := c.newRequest(c.baseURL() + "/global")
+	if err != nil {
+		return GlobalMarketData{}, err
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return GlobalMarketData{}, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return GlobalMarketData{}, fmt.Errorf("coingecko: unexpected status %s", resp.Status)
+	}
+
+	var raw struct {
+		Data struct {
+			TotalMarketCap map[string]float64 `json:"total_market_cap"`
+			TotalVolume    map[string]float64 `json:"total_volume"`
+			MarketCapPct   map[string]float64 `json:"market_cap_percentage"`
+		} `json:"data"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&raw); err != nil {
+		return GlobalMarketData{}, err
+	}
+
+	return GlobalMarketData{
+		TotalMarketCapUSD: raw.Data.TotalMarketCap["usd"],
+		TotalVolume24hUSD: raw.Data.TotalVolume["usd"],
+		BTCDominance:      raw.Data.MarketCapPct["btc"],
+	}, nil
+}
+
+// Ping implements MarketDataProvider.
+func (c *CoinGecko) Ping() error {
+	req, err := c.newRequest(c.baseURL() + "/ping")
+	if err != nil {
+		return err
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("coingecko: unexpected status %s", resp.Status)
+	}
+
+	return nil
+}
@@ -0,0 +1,76 @@
+package market
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+)
+
+// newCoinGeckoFixture serves total coins across /coins/markets pages of
+// up to coinGeckoPerPage each, so pagination can be exercised without
+// hitting the real API.
+func newCoinGeckoFixture(t *testing.T, total int) *CoinGecko {
+	t.Helper()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+		perPage, _ := strconv.Atoi(r.URL.Query().Get("per_page"))
+
+		start := (page - 1) * perPage
+		end := start + perPage
+		if end > total {
+			end = total
+		}
+		if start > total {
+			start = total
+		}
+
+		type coin struct {
+			Symbol       string  `json:"symbol"`
+			Name         string  `json:"id"`
+			CurrentPrice float64 `json:"current_price"`
+		}
+
+		coins := make([]coin, 0, end-start)
+		for i := start; i < end; i++ {
+			coins = append(coins, coin{Symbol: fmt.Sprintf("c%d", i), Name: fmt.Sprintf("coin%d", i), CurrentPrice: float64(i)})
+		}
+
+		json.NewEncoder(w).Encode(coins)
+	}))
+	t.Cleanup(server.Close)
+
+	cg := NewCoinGecko("", false)
+	cg.BaseURL = server.URL
+	return cg
+}
+
+func TestCoinGeckoGetAllCoinDataNoLimitPaginates(t *testing.T) {
+	total := coinGeckoPerPage + 10
+	cg := newCoinGeckoFixture(t, total)
+
+	coins, err := cg.GetAllCoinData(0)
+	if err != nil {
+		t.Fatalf("GetAllCoinData(0) error = %v", err)
+	}
+
+	if len(coins) != total {
+		t.Errorf("GetAllCoinData(0) returned %d coins, want %d (beyond a single page)", len(coins), total)
+	}
+}
+
+func TestCoinGeckoGetAllCoinDataRespectsLimit(t *testing.T) {
+	cg := newCoinGeckoFixture(t, coinGeckoPerPage+10)
+
+	coins, err := cg.GetAllCoinData(5)
+	if err != nil {
+		t.Fatalf("GetAllCoinData(5) error = %v", err)
+	}
+
+	if len(coins) != 5 {
+		t.Errorf("GetAllCoinData(5) returned %d coins, want 5", len(coins))
+	}
+}
@@ -0,0 +1,240 @@
+package market
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+const coinMarketCapBaseURL = "https://pro-api.coinmarketcap.com/v1"
+
+// CoinMarketCap is a MarketDataProvider backed by the CoinMarketCap API.
+// Unlike CoinGecko, CoinMarketCap requires an API key for every request.
+type CoinMarketCap struct {
+	APIKey string
+
+	httpClient *http.Client
+}
+
+// NewCoinMarketCap returns a CoinMarketCap provider.
+func NewCoinMarketCap(apiKey string) *CoinMarketCap {
+	return &CoinMarketCap{
+		APIKey:     apiKey,
+		httpClient: &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+func (c *CoinMarketCap) newRequest(url string) (*http.Request, error) {
+	if c.APIKey == "" {
+		return nil, errors.New("coinmarketcap: API key is required")
+	}
+
+	req, err := http.NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("X-CMC_PRO_API_KEY", c.APIKey)
+	req.Header.Set("Accept", "application/json")
+
+	return req, nil
+}
+
+// coinMarketCapPageSize is the largest page size CoinMarketCap's
+// /cryptocurrency/listings/latest accepts per request.
+const coinMarketCapPageSize = 5000
+
+// coinMarketCapMaxPages bounds how many pages GetAllCoinData(0) will
+// walk, comfortably above CoinMarketCap's total tracked coin count, so
+// a listing endpoint that never ends can't loop forever.
+const coinMarketCapMaxPages = 10
+
+// GetAllCoinData implements MarketDataProvider. A limit of 0 paginates
+// through every page CoinMarketCap returns via start/limit instead of
+// stopping at the first 100, so callers looking up a coin outside the
+// top of the list still find it.
+func (c *CoinMarketCap) GetAllCoinData(limit int) ([]CoinData, error) {
+	var coins []CoinData
+
+	for page := 0; page < coinMarketCapMaxPages; page++ {
+		start := page*coinMarketCapPageSize + 1 // CMC's start index is 1-based
+		pageCoins, err := c.fetchCoinsPage(start, coinMarketCapPageSize)
+		if err != nil {
+			return nil, err
+		}
+
+		coins = append(coins, pageCoins...)
+		if limit > 0 && len(coins) >= limit {
+			return coins[:limit], nil
+		}
+
+		if len(pageCoins) < coinMarketCapPageSize {
+			// Fewer coins than a full page means we've reached the end
+			// of CoinMarketCap's list.
+			break
+		}
+	}
+
+	return coins, nil
+}
+
+func (c *CoinMarketCap) fetchCoinsPage(start, limit int) ([]CoinData, error) {
+	url := fmt.Sprintf("%s/cryptocurrency/listings/latest?start=%d&limit=%d", coinMarketCapBaseURL, start, limit)
+	req, err := c.newRequest(url)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("coinmarketcap: unexpected status %s", resp.Status)
+	}
+
+	var raw struct {
+		Data []struct {
+			Symbol string `json:"symbol"`
+			Name   string `json:"name"`
+			Quote  struct {
+				USD struct {
+					Price float64 `json:"price"`
+				} `json:"USD"`
+			} `json:"quote"`
+		} `json:"data"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&raw); err != nil {
+		return nil, err
+	}
+
+	coins := make([]CoinData, 0, len(raw.Data))
+	for _, r := range raw.Data {
+		coins = append(coins, CoinData{Symbol: r.Symbol, Name: r.Name, Price: r.Quote.USD.Price})
+	}
+
+	return coins, nil
+}
+
+// GetCoinGraphData implements MarketDataProvider.
+//
+// CoinMarketCap's historical quotes endpoint is a paid add-on; jrdev
+// calls it the same way regardless of plan and surfaces any entitlement
+// error from the API as-is.
+func (c *CoinMarketCap) GetCoinGraphData(symbol, name string, start, end int64) (GraphData, error) {
+	url := fmt.Sprintf("%s/cryptocurrency/quotes/historical?symbol=%s&time_start=%d&time_end=%d",
+		coinMarketCapBaseURL, symbol, start, end)
+	req, err := c.newRequest(url)
+	if err != nil {
+		return GraphData{}, err
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return GraphData{}, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return GraphData{}, fmt.Errorf("coinmarketcap: unexpected status %s", resp.Status)
+	}
+
+	var raw struct {
+		Data struct {
+			Quotes []struct {
+				Timestamp string `json:"timestamp"`
+				Quote     struct {
+					USD struct {
+						Price     float64 `json:"price"`
+						Volume24h float64 `json:"volume_24h"`
+					} `json:"USD"`
+				} `json:"quote"`
+			} `json:"quotes"`
+		} `json:"data"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&raw); err != nil {
+		return GraphData{}, err
+	}
+
+	candles := make([]Candle, 0, len(raw.Data.Quotes))
+	for _, q := range raw.Data.Quotes {
+		t, err := time.Parse(time.RFC3339, q.Timestamp)
+		if err != nil {
+			continue
+		}
+
+		candles = append(candles, Candle{
+			Time:   t.Unix(),
+			Open:   q.Quote.USD.Price,
+			High:   q.Quote.USD.Price,
+			Low:    q.Quote.USD.Price,
+			Close:  q.Quote.USD.Price,
+			Volume: q.Quote.USD.Volume24h,
+		})
+	}
+
+	return GraphData{Coin: name, Candles: candles}, nil
+}
+
+// GetGlobalMarketData implements MarketDataProvider.
+func (c *CoinMarketCap) GetGlobalMarketData() (GlobalMarketData, error) {
+	req, err := c.newRequest(coinMarketCapBaseURL + "/global-metrics/quotes/latest")
+	if err != nil {
+		return GlobalMarketData{}, err
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return GlobalMarketData{}, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return GlobalMarketData{}, fmt.Errorf("coinmarketcap: unexpected status %s", resp.Status)
+	}
+
+	var raw struct {
+		Data struct {
+			BTCDominance float64 `json:"btc_dominance"`
+			Quote        struct {
+				USD struct {
+					TotalMarketCap float64 `json:"total_market_cap"`
+					TotalVolume24h float64 `json:"total_volume_24h"`
+				} `json:"USD"`
+			} `json:"quote"`
+		} `json:"data"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&raw); err != nil {
+		return GlobalMarketData{}, err
+	}
+
+	return GlobalMarketData{
+		TotalMarketCapUSD: raw.Data.Quote.USD.TotalMarketCap,
+		TotalVolume24hUSD: raw.Data.Quote.USD.TotalVolume24h,
+		BTCDominance:      raw.Data.BTCDominance,
+	}, nil
+}
+
+// Ping implements MarketDataProvider.
+func (c *CoinMarketCap) Ping() error {
+	req, err := c.newRequest(coinMarketCapBaseURL + "/key/info")
+	if err != nil {
+		return err
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("coinmarketcap: unexpected status %s", resp.Status)
+	}
+
+	return nil
+}
@@ -0,0 +1,44 @@
+package market
+
+import (
+	"path/filepath"
+	"time"
+)
+
+// cacheDirName is the subdirectory of the jrdev config dir that holds
+// CachingProvider's on-disk graph data cache.
+const cacheDirName = "cache"
+
+// ProviderSettings is the subset of config.Settings FromSettings needs.
+// It is a plain struct, not config.Settings itself, so this package
+// does not have to import config.
+type ProviderSettings struct {
+	// Provider selects the backend: "coingecko" (the default, and used
+	// for any unrecognized value) or "coinmarketcap".
+	Provider string
+
+	// APIKey is sent to Provider's API, if it needs one.
+	APIKey string
+
+	// APIKeyPro marks APIKey as a CoinGecko pro key rather than a demo
+	// key. It has no effect for CoinMarketCap.
+	APIKeyPro bool
+
+	// CacheTTL is how long a cached graph data response is considered
+	// fresh.
+	CacheTTL time.Duration
+}
+
+// FromSettings builds the MarketDataProvider selected by settings,
+// wrapped in a CachingProvider rooted at configDir.
+func FromSettings(settings ProviderSettings, configDir string) (MarketDataProvider, error) {
+	var provider MarketDataProvider
+	switch settings.Provider {
+	case "coinmarketcap":
+		provider = NewCoinMarketCap(settings.APIKey)
+	default:
+		provider = NewCoinGecko(settings.APIKey, settings.APIKeyPro)
+	}
+
+	return NewCachingProvider(provider, filepath.Join(configDir, cacheDirName), settings.CacheTTL)
+}
@@ -0,0 +1,39 @@
+package market
+
+import "testing"
+
+func TestFromSettingsSelectsProvider(t *testing.T) {
+	dir := t.TempDir()
+
+	provider, err := FromSettings(ProviderSettings{Provider: "coinmarketcap", APIKey: "key"}, dir)
+	if err != nil {
+		t.Fatalf("FromSettings error = %v", err)
+	}
+
+	caching, ok := provider.(*CachingProvider)
+	if !ok {
+		t.Fatalf("FromSettings returned %T, want *CachingProvider", provider)
+	}
+
+	if _, ok := caching.MarketDataProvider.(*CoinMarketCap); !ok {
+		t.Errorf("FromSettings wrapped %T, want *CoinMarketCap", caching.MarketDataProvider)
+	}
+}
+
+func TestFromSettingsDefaultsToCoinGecko(t *testing.T) {
+	dir := t.TempDir()
+
+	provider, err := FromSettings(ProviderSettings{}, dir)
+	if err != nil {
+		t.Fatalf("FromSettings error = %v", err)
+	}
+
+	caching, ok := provider.(*CachingProvider)
+	if !ok {
+		t.Fatalf("FromSettings returned %T, want *CachingProvider", provider)
+	}
+
+	if _, ok := caching.MarketDataProvider.(*CoinGecko); !ok {
+		t.Errorf("FromSettings wrapped %T, want *CoinGecko", caching.MarketDataProvider)
+	}
+}
@@ -0,0 +1,51 @@
+// Package market defines the pricing/graph data backends PriceChart can
+// be driven by, independent of any particular API vendor.
+package market
+
+// CoinData is a single coin's snapshot pricing data.
+type CoinData struct {
+	Symbol string
+	Name   string
+	Price  float64
+}
+
+// Candle is one OHLCV sample of a coin's graph data.
+type Candle struct {
+	Time   int64
+	Open   float64
+	High   float64
+	Low    float64
+	Close  float64
+	Volume float64
+}
+
+// GraphData is a coin's candle series for a requested time window.
+type GraphData struct {
+	Coin    string
+	Candles []Candle
+}
+
+// GlobalMarketData is aggregate stats across the whole market.
+type GlobalMarketData struct {
+	TotalMarketCapUSD float64
+	TotalVolume24hUSD float64
+	BTCDominance      float64
+}
+
+// MarketDataProvider is implemented by any backend PriceChart can pull
+// live pricing and candle data from.
+type MarketDataProvider interface {
+	// GetAllCoinData returns the top limit coins by market cap. A limit
+	// of 0 means no limit.
+	GetAllCoinData(limit int) ([]CoinData, error)
+
+	// GetCoinGraphData returns candles for coin between start and end,
+	// given as Unix seconds.
+	GetCoinGraphData(symbol, name string, start, end int64) (GraphData, error)
+
+	GetGlobalMarketData() (GlobalMarketData, error)
+
+	// Ping reports whether the backend is reachable and credentials, if
+	// any, are valid.
+	Ping() error
+}